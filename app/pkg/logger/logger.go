@@ -0,0 +1,80 @@
+// Package logger builds the service's zap-based structured logger and
+// carries per-request loggers through context.Context so that every log
+// line emitted while handling a request is automatically correlated.
+package logger
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"os"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+// Config controls the base logger's output and rotation behavior.
+type Config struct {
+	Filename   string // rotated log file path; empty disables file output
+	MaxSize    int    // megabytes before rotation
+	MaxBackups int    // number of rotated files to retain
+	MaxAge     int    // days to retain rotated files
+	Compress   bool   // gzip rotated files
+	Console    bool   // also write human-readable output to stdout
+	Level      string // zap level name, e.g. "info", "debug"
+}
+
+// New builds the base *zap.Logger from cfg. File output is always JSON;
+// console output (when enabled, or when no file is configured) uses zap's
+// console encoder.
+func New(cfg Config) (*zap.Logger, error) {
+	var level zapcore.Level
+	if err := level.UnmarshalText([]byte(cfg.Level)); err != nil {
+		level = zapcore.InfoLevel
+	}
+
+	encoderCfg := zap.NewProductionEncoderConfig()
+	encoderCfg.TimeKey = "timestamp"
+	encoderCfg.EncodeTime = zapcore.ISO8601TimeEncoder
+
+	var cores []zapcore.Core
+	if cfg.Filename != "" {
+		writer := zapcore.AddSync(&lumberjack.Logger{
+			Filename:   cfg.Filename,
+			MaxSize:    cfg.MaxSize,
+			MaxBackups: cfg.MaxBackups,
+			MaxAge:     cfg.MaxAge,
+			Compress:   cfg.Compress,
+		})
+		cores = append(cores, zapcore.NewCore(zapcore.NewJSONEncoder(encoderCfg), writer, level))
+	}
+	if cfg.Console || len(cores) == 0 {
+		cores = append(cores, zapcore.NewCore(zapcore.NewConsoleEncoder(encoderCfg), zapcore.AddSync(os.Stdout), level))
+	}
+
+	return zap.New(zapcore.NewTee(cores...), zap.AddCaller()), nil
+}
+
+type contextKey struct{}
+
+// NewContext returns a copy of ctx carrying l, retrievable via FromContext.
+func NewContext(ctx context.Context, l *zap.Logger) context.Context {
+	return context.WithValue(ctx, contextKey{}, l)
+}
+
+// FromContext returns the logger carried by ctx, or a no-op logger if none
+// was attached (e.g. in tests that don't go through an interceptor).
+func FromContext(ctx context.Context) *zap.Logger {
+	if l, ok := ctx.Value(contextKey{}).(*zap.Logger); ok {
+		return l
+	}
+	return zap.NewNop()
+}
+
+// NewTraceID generates a random per-request correlation ID.
+func NewTraceID() string {
+	var b [16]byte
+	_, _ = rand.Read(b[:])
+	return hex.EncodeToString(b[:])
+}
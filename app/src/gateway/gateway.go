@@ -0,0 +1,24 @@
+// Package gateway exposes the UserService proto as REST/JSON routes, built
+// from the same google.api.http annotations that generate the grpc-gateway
+// bindings in proto/. Handlers are invoked in-process so REST traffic never
+// leaves the pod to talk to the gRPC server.
+package gateway
+
+import (
+	"context"
+
+	"user-service/proto"
+	"user-service/src/handlers"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+)
+
+// New builds an http.Handler serving the REST bindings generated for
+// UserService, calling straight into h rather than dialing back over gRPC.
+func New(ctx context.Context, h *handlers.UserHandler) (*runtime.ServeMux, error) {
+	mux := runtime.NewServeMux()
+	if err := proto.RegisterUserServiceHandlerServer(ctx, mux, h); err != nil {
+		return nil, err
+	}
+	return mux, nil
+}
@@ -3,53 +3,52 @@ package handlers
 import (
 	"context"
 
+	"user-service/pkg/logger"
 	"user-service/proto"
 	"user-service/src/services"
 
-	"github.com/sirupsen/logrus"
+	"go.uber.org/zap"
 )
 
 type UserHandler struct {
 	proto.UnimplementedUserServiceServer
 	userService *services.UserService
-	logger      *logrus.Logger
 }
 
-func NewUserHandler(userService *services.UserService, logger *logrus.Logger) *UserHandler {
+func NewUserHandler(userService *services.UserService) *UserHandler {
 	return &UserHandler{
 		userService: userService,
-		logger:      logger,
 	}
 }
 
 func (h *UserHandler) GetUser(ctx context.Context, req *proto.GetUserRequest) (*proto.GetUserResponse, error) {
-	h.logger.WithField("user_id", req.Id).Info("Handling GetUser request")
+	logger.FromContext(ctx).Info("Handling GetUser request", zap.String("user_id", req.Id))
 	return h.userService.GetUser(ctx, req)
 }
 
 func (h *UserHandler) CreateUser(ctx context.Context, req *proto.CreateUserRequest) (*proto.CreateUserResponse, error) {
-	h.logger.WithFields(logrus.Fields{
-		"name":  req.Name,
-		"email": req.Email,
-		"age":   req.Age,
-	}).Info("Handling CreateUser request")
+	logger.FromContext(ctx).Info("Handling CreateUser request",
+		zap.String("name", req.Name),
+		zap.String("email", req.Email),
+		zap.Int32("age", req.Age),
+	)
 	return h.userService.CreateUser(ctx, req)
 }
 
 func (h *UserHandler) UpdateUser(ctx context.Context, req *proto.UpdateUserRequest) (*proto.UpdateUserResponse, error) {
-	h.logger.WithField("user_id", req.Id).Info("Handling UpdateUser request")
+	logger.FromContext(ctx).Info("Handling UpdateUser request", zap.String("user_id", req.Id))
 	return h.userService.UpdateUser(ctx, req)
 }
 
 func (h *UserHandler) DeleteUser(ctx context.Context, req *proto.DeleteUserRequest) (*proto.DeleteUserResponse, error) {
-	h.logger.WithField("user_id", req.Id).Info("Handling DeleteUser request")
+	logger.FromContext(ctx).Info("Handling DeleteUser request", zap.String("user_id", req.Id))
 	return h.userService.DeleteUser(ctx, req)
 }
 
 func (h *UserHandler) ListUsers(ctx context.Context, req *proto.ListUsersRequest) (*proto.ListUsersResponse, error) {
-	h.logger.WithFields(logrus.Fields{
-		"page":  req.Page,
-		"limit": req.Limit,
-	}).Info("Handling ListUsers request")
+	logger.FromContext(ctx).Info("Handling ListUsers request",
+		zap.Int32("page", req.Page),
+		zap.Int32("limit", req.Limit),
+	)
 	return h.userService.ListUsers(ctx, req)
 }
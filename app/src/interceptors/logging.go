@@ -0,0 +1,45 @@
+// Package interceptors holds gRPC unary interceptors shared by the server
+// in main.go.
+package interceptors
+
+import (
+	"context"
+	"time"
+
+	"user-service/pkg/logger"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// UnaryLoggingInterceptor injects a per-request logger carrying trace_id,
+// method, and peer into the request context, then logs duration_ms and code
+// once the handler returns so every RPC's log lines are correlated.
+func UnaryLoggingInterceptor(base *zap.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+
+		peerAddr := "unknown"
+		if p, ok := peer.FromContext(ctx); ok {
+			peerAddr = p.Addr.String()
+		}
+
+		reqLogger := base.With(
+			zap.String("trace_id", logger.NewTraceID()),
+			zap.String("method", info.FullMethod),
+			zap.String("peer", peerAddr),
+		)
+		ctx = logger.NewContext(ctx, reqLogger)
+
+		resp, err := handler(ctx, req)
+
+		reqLogger.Info("grpc request completed",
+			zap.Int64("duration_ms", time.Since(start).Milliseconds()),
+			zap.String("code", status.Code(err).String()),
+		)
+
+		return resp, err
+	}
+}
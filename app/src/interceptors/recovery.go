@@ -0,0 +1,31 @@
+package interceptors
+
+import (
+	"context"
+	"fmt"
+
+	"user-service/pkg/logger"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// UnaryRecoveryInterceptor recovers panics in handlers and converts them
+// into an Internal status error instead of crashing the process.
+func UnaryRecoveryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				logger.FromContext(ctx).Error("recovered from panic in gRPC handler",
+					zap.String("method", info.FullMethod),
+					zap.Any("panic", r),
+				)
+				err = status.Error(codes.Internal, fmt.Sprintf("internal error: %v", r))
+			}
+		}()
+
+		return handler(ctx, req)
+	}
+}
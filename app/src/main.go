@@ -2,140 +2,317 @@ package main
 
 import (
 	"context"
+	"flag"
+	"fmt"
 	"log"
 	"net"
+	"net/http"
+	"net/http/pprof"
 	"os"
 	"os/signal"
+	"sync/atomic"
 	"syscall"
 	"time"
 
+	"user-service/pkg/logger"
 	"user-service/proto"
+	"user-service/src/gateway"
 	"user-service/src/handlers"
+	"user-service/src/interceptors"
+	"user-service/src/middleware"
 	"user-service/src/services"
+	"user-service/src/storage"
 
 	"github.com/gin-gonic/gin"
-	"github.com/sirupsen/logrus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/spf13/viper"
+	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 )
 
+// serviceName is the service name reported on the grpc.health.v1.Health API,
+// and the name Linkerd/Kubernetes gRPC probes should check against.
+const serviceName = "user-service"
+
 func main() {
 	// Initialize configuration
 	initConfig()
 
 	// Initialize logger
-	logger := logrus.New()
-	logger.SetFormatter(&logrus.JSONFormatter{})
-	logger.SetLevel(logrus.InfoLevel)
+	zapLogger, err := logger.New(logger.Config{
+		Filename:   viper.GetString("log.filename"),
+		MaxSize:    viper.GetInt("log.max_size"),
+		MaxBackups: viper.GetInt("log.max_backups"),
+		MaxAge:     viper.GetInt("log.max_age"),
+		Compress:   viper.GetBool("log.compress"),
+		Console:    viper.GetBool("log.console"),
+		Level:      viper.GetString("log.level"),
+	})
+	if err != nil {
+		log.Fatalf("Failed to initialize logger: %v", err)
+	}
+	defer zapLogger.Sync()
+
+	// Initialize storage backend and services
+	store, err := newStorage(context.Background())
+	if err != nil {
+		zapLogger.Fatal("Failed to initialize storage", zap.Error(err))
+	}
+	defer store.Close()
 
-	// Initialize services
-	userService := services.NewUserService(logger)
+	userService := services.NewUserService(storage.NewInstrumented(store))
+	userHandler := handlers.NewUserHandler(userService)
+	healthServer := health.NewServer()
+
+	// grpcReady flips to true once the gRPC server has finished registering
+	// its services, and draining flips to true once shutdown begins, so
+	// /ready can report 503 outside that window.
+	var grpcReady atomic.Bool
+	var draining atomic.Bool
 
 	// Start gRPC server
-	grpcServer := startGRPCServer(userService, logger)
-	
-	// Start HTTP server for health checks and metrics
-	httpServer := startHTTPServer(logger)
+	grpcServer := startGRPCServer(userHandler, healthServer, zapLogger, &grpcReady)
+
+	// Start HTTP server for health checks, metrics, and the REST gateway
+	httpServer, err := startHTTPServer(context.Background(), userService, userHandler, zapLogger, &grpcReady, &draining)
+	if err != nil {
+		zapLogger.Fatal("Failed to start HTTP server", zap.Error(err))
+	}
 
 	// Wait for interrupt signal to gracefully shutdown the servers
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
-	logger.Info("Shutting down servers...")
+	zapLogger.Info("Shutting down servers...")
+
+	// Stop advertising SERVING immediately so meshed clients (Linkerd) and
+	// /ready route traffic elsewhere, then give in-flight requests a chance
+	// to finish being routed away before we actually stop accepting them.
+	// Shutdown (rather than SetServingStatus(serviceName, ...)) flips every
+	// known service, including the default "" entry probes use when no
+	// service name is configured, to NOT_SERVING.
+	draining.Store(true)
+	healthServer.Shutdown()
+
+	drainDelay := viper.GetDuration("shutdown.drain_delay")
+	if drainDelay > 0 {
+		zapLogger.Info("Draining before shutdown", zap.Duration("drain_delay", drainDelay))
+		time.Sleep(drainDelay)
+	}
 
 	// Graceful shutdown
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
 
 	grpcServer.GracefulStop()
-	
+
 	if err := httpServer.Shutdown(ctx); err != nil {
-		logger.Fatal("HTTP server forced to shutdown:", err)
+		zapLogger.Fatal("HTTP server forced to shutdown", zap.Error(err))
 	}
 
-	logger.Info("Servers exited")
+	zapLogger.Info("Servers exited")
 }
 
 func initConfig() {
 	viper.SetDefault("grpc.port", "50051")
 	viper.SetDefault("http.port", "8080")
 	viper.SetDefault("log.level", "info")
-	
+	viper.SetDefault("log.console", true)
+	viper.SetDefault("log.max_size", 100)
+	viper.SetDefault("log.max_backups", 3)
+	viper.SetDefault("log.max_age", 28)
+	viper.SetDefault("log.compress", true)
+	viper.SetDefault("storage.driver", "memory")
+	viper.SetDefault("storage.pool_size", 10)
+	viper.SetDefault("pprof.enable", false)
+	viper.SetDefault("shutdown.drain_delay", "5s")
+
 	viper.SetConfigName("config")
 	viper.SetConfigType("yaml")
 	viper.AddConfigPath("./configs")
 	viper.AddConfigPath(".")
-	
+
 	if err := viper.ReadInConfig(); err != nil {
 		log.Printf("Warning: Could not read config file: %v", err)
 	}
+
+	// --pprof overrides pprof.enable from the config file/defaults above.
+	pprofEnable := flag.Bool("pprof", viper.GetBool("pprof.enable"), "enable /debug/pprof/* endpoints on the HTTP server")
+	flag.Parse()
+	viper.Set("pprof.enable", *pprofEnable)
+}
+
+// newStorage selects a Storage backend based on the storage.driver config
+// value ("memory", "postgres" or "redis") and its connection settings.
+func newStorage(ctx context.Context) (storage.Storage, error) {
+	driver := viper.GetString("storage.driver")
+	poolSize := viper.GetInt("storage.pool_size")
+
+	switch driver {
+	case "memory":
+		return storage.NewMemoryStorage(), nil
+	case "postgres":
+		return storage.NewPostgresStorage(ctx, storage.PostgresConfig{
+			URL:      viper.GetString("storage.url"),
+			PoolSize: poolSize,
+		})
+	case "redis":
+		return storage.NewRedisStorage(storage.RedisConfig{
+			URL:      viper.GetString("storage.url"),
+			PoolSize: poolSize,
+		})
+	default:
+		return nil, fmt.Errorf("unsupported storage.driver %q", driver)
+	}
 }
 
-func startGRPCServer(userService *services.UserService, logger *logrus.Logger) *grpc.Server {
+func startGRPCServer(userHandler *handlers.UserHandler, healthServer *health.Server, zapLogger *zap.Logger, grpcReady *atomic.Bool) *grpc.Server {
 	port := viper.GetString("grpc.port")
 	lis, err := net.Listen("tcp", ":"+port)
 	if err != nil {
-		logger.Fatalf("Failed to listen on port %s: %v", port, err)
+		zapLogger.Fatal("Failed to listen", zap.String("port", port), zap.Error(err))
 	}
 
-	grpcServer := grpc.NewServer()
-	
+	grpcServer := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(
+			// Logging must wrap recovery, not the other way around: it injects
+			// the trace_id/peer fields into ctx before recovery's deferred
+			// recover() runs, so a panic log line is correlated like every
+			// other log line instead of using the original, unenriched ctx.
+			interceptors.UnaryLoggingInterceptor(zapLogger),
+			interceptors.UnaryRecoveryInterceptor(),
+			interceptors.UnaryMetricsInterceptor(),
+		),
+	)
+
 	// Register services
-	proto.RegisterUserServiceServer(grpcServer, handlers.NewUserHandler(userService, logger))
-	
+	proto.RegisterUserServiceServer(grpcServer, userHandler)
+
+	// Register grpc.health.v1.Health so Linkerd/Kubernetes can use
+	// gRPC-native readiness/liveness probes instead of TCP checks.
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+	healthServer.SetServingStatus(serviceName, healthpb.HealthCheckResponse_SERVING)
+
 	// Enable reflection for debugging
 	reflection.Register(grpcServer)
 
-	logger.Infof("gRPC server listening on port %s", port)
-	
+	grpcReady.Store(true)
+	zapLogger.Info("gRPC server listening", zap.String("port", port))
+
 	go func() {
 		if err := grpcServer.Serve(lis); err != nil {
-			logger.Fatalf("Failed to serve gRPC: %v", err)
+			zapLogger.Fatal("Failed to serve gRPC", zap.Error(err))
 		}
 	}()
 
 	return grpcServer
 }
 
-func startHTTPServer(logger *logrus.Logger) *gin.Engine {
+func startHTTPServer(ctx context.Context, userService *services.UserService, userHandler *handlers.UserHandler, zapLogger *zap.Logger, grpcReady, draining *atomic.Bool) (*http.Server, error) {
 	port := viper.GetString("http.port")
-	
+
 	gin.SetMode(gin.ReleaseMode)
 	router := gin.New()
-	router.Use(gin.Logger(), gin.Recovery())
+	router.Use(middleware.RequestLogger(zapLogger), middleware.Metrics(), gin.Recovery())
+
+	// REST/JSON gateway for the same UserService, generated from
+	// proto/user_service.proto's google.api.http annotations. It calls
+	// userHandler directly, so it shares validation, logging, and the gRPC
+	// server's in-process state without an extra network hop.
+	gatewayMux, err := gateway.New(ctx, userHandler)
+	if err != nil {
+		return nil, fmt.Errorf("build REST gateway: %w", err)
+	}
+	router.Any("/v1/*grpcGatewayPath", gin.WrapH(gatewayMux))
+
+	// OpenAPI document generated alongside the gateway bindings.
+	router.StaticFile("/swagger.json", "./api/openapi/user_service.swagger.json")
 
 	// Health check endpoint
 	router.GET("/health", func(c *gin.Context) {
 		c.JSON(200, gin.H{
-			"status": "healthy",
+			"status":    "healthy",
 			"timestamp": time.Now().UTC(),
-			"service": "user-service",
+			"service":   "user-service",
 		})
 	})
 
 	// Metrics endpoint
-	router.GET("/metrics", func(c *gin.Context) {
-		c.JSON(200, gin.H{
-			"requests_total": 0,
-			"uptime": time.Since(time.Now()).String(),
-		})
-	})
+	router.GET("/metrics", gin.WrapH(promhttp.Handler()))
 
-	// Readiness probe
+	// Readiness probe - fails while gRPC hasn't finished registering, while
+	// draining for shutdown, or if the storage backend is unreachable
 	router.GET("/ready", func(c *gin.Context) {
+		if draining.Load() {
+			c.JSON(503, gin.H{
+				"status": "not ready",
+				"error":  "server is draining for shutdown",
+			})
+			return
+		}
+
+		if !grpcReady.Load() {
+			c.JSON(503, gin.H{
+				"status": "not ready",
+				"error":  "grpc server is not yet registered",
+			})
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(c.Request.Context(), 2*time.Second)
+		defer cancel()
+
+		if err := userService.Ready(ctx); err != nil {
+			c.JSON(503, gin.H{
+				"status": "not ready",
+				"error":  err.Error(),
+			})
+			return
+		}
+
 		c.JSON(200, gin.H{
 			"status": "ready",
 		})
 	})
 
-	logger.Infof("HTTP server listening on port %s", port)
-	
+	if viper.GetBool("pprof.enable") {
+		registerPprof(router)
+	}
+
+	zapLogger.Info("HTTP server listening", zap.String("port", port))
+
+	srv := &http.Server{
+		Addr:    ":" + port,
+		Handler: router,
+	}
+
 	go func() {
-		if err := router.Run(":" + port); err != nil {
-			logger.Fatalf("Failed to start HTTP server: %v", err)
+		if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			zapLogger.Fatal("Failed to start HTTP server", zap.Error(err))
 		}
 	}()
 
-	return router
+	return srv, nil
+}
+
+// registerPprof mounts net/http/pprof's handlers under /debug/pprof, gated
+// behind the --pprof flag / pprof.enable config since they leak process
+// internals and shouldn't be exposed by default.
+func registerPprof(router *gin.Engine) {
+	group := router.Group("/debug/pprof")
+	group.GET("/", gin.WrapF(pprof.Index))
+	group.GET("/cmdline", gin.WrapF(pprof.Cmdline))
+	group.GET("/profile", gin.WrapF(pprof.Profile))
+	group.POST("/symbol", gin.WrapF(pprof.Symbol))
+	group.GET("/symbol", gin.WrapF(pprof.Symbol))
+	group.GET("/trace", gin.WrapF(pprof.Trace))
+	group.GET("/allocs", gin.WrapH(pprof.Handler("allocs")))
+	group.GET("/block", gin.WrapH(pprof.Handler("block")))
+	group.GET("/goroutine", gin.WrapH(pprof.Handler("goroutine")))
+	group.GET("/heap", gin.WrapH(pprof.Handler("heap")))
+	group.GET("/mutex", gin.WrapH(pprof.Handler("mutex")))
+	group.GET("/threadcreate", gin.WrapH(pprof.Handler("threadcreate")))
 }
@@ -0,0 +1,49 @@
+// Package metrics holds the Prometheus collectors shared by the gRPC and
+// storage instrumentation in this service.
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	// GRPCRequestsTotal counts completed unary RPCs by method and status code.
+	GRPCRequestsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "user_service_grpc_requests_total",
+		Help: "Total number of gRPC requests processed, by method and status code.",
+	}, []string{"method", "code"})
+
+	// GRPCRequestDuration tracks unary RPC latency by method.
+	GRPCRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "user_service_grpc_request_duration_seconds",
+		Help:    "Latency of gRPC requests in seconds, by method.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"method"})
+
+	// HTTPRequestsTotal counts completed REST gateway requests by route and status code.
+	HTTPRequestsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "user_service_http_requests_total",
+		Help: "Total number of HTTP requests processed, by route and status code.",
+	}, []string{"route", "code"})
+
+	// HTTPRequestDuration tracks REST gateway request latency by route.
+	HTTPRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "user_service_http_request_duration_seconds",
+		Help:    "Latency of HTTP requests in seconds, by route.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"route"})
+
+	// StorageOperationsTotal counts Storage calls by operation and outcome.
+	StorageOperationsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "user_service_storage_operations_total",
+		Help: "Total number of storage operations processed, by operation and result.",
+	}, []string{"operation", "result"})
+
+	// StorageOperationDuration tracks Storage call latency by operation.
+	StorageOperationDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "user_service_storage_operation_duration_seconds",
+		Help:    "Latency of storage operations in seconds, by operation.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"operation"})
+)
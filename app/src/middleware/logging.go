@@ -0,0 +1,34 @@
+// Package middleware holds gin middleware shared by the HTTP server in main.go.
+package middleware
+
+import (
+	"time"
+
+	"user-service/pkg/logger"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// RequestLogger injects a per-request logger carrying trace_id, method, and
+// peer into the request context, then logs duration_ms and code once the
+// request completes so every route's log lines are correlated.
+func RequestLogger(base *zap.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+
+		reqLogger := base.With(
+			zap.String("trace_id", logger.NewTraceID()),
+			zap.String("method", c.Request.Method+" "+c.FullPath()),
+			zap.String("peer", c.ClientIP()),
+		)
+		c.Request = c.Request.WithContext(logger.NewContext(c.Request.Context(), reqLogger))
+
+		c.Next()
+
+		reqLogger.Info("http request completed",
+			zap.Int64("duration_ms", time.Since(start).Milliseconds()),
+			zap.Int("code", c.Writer.Status()),
+		)
+	}
+}
@@ -0,0 +1,30 @@
+package middleware
+
+import (
+	"strconv"
+	"time"
+
+	"user-service/src/metrics"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Metrics records user_service_http_requests_total and
+// user_service_http_request_duration_seconds for every HTTP request,
+// mirroring UnaryMetricsInterceptor so the REST gateway's traffic is
+// counted alongside gRPC's.
+func Metrics() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+
+		c.Next()
+
+		route := c.FullPath()
+		if route == "" {
+			route = c.Request.URL.Path
+		}
+
+		metrics.HTTPRequestDuration.WithLabelValues(route).Observe(time.Since(start).Seconds())
+		metrics.HTTPRequestsTotal.WithLabelValues(route, strconv.Itoa(c.Writer.Status())).Inc()
+	}
+}
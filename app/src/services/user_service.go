@@ -2,122 +2,106 @@ package services
 
 import (
 	"context"
-	"fmt"
+	"errors"
 	"time"
 
+	"user-service/pkg/logger"
 	"user-service/proto"
+	"user-service/src/storage"
+	"user-service/src/validation"
 
-	"github.com/sirupsen/logrus"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
-type User struct {
-	ID        string    `json:"id"`
-	Name      string    `json:"name"`
-	Email     string    `json:"email"`
-	Age       int32     `json:"age"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
-}
-
 type UserService struct {
-	logger *logrus.Logger
-	users  map[string]*User
+	storage storage.Storage
 }
 
-func NewUserService(logger *logrus.Logger) *UserService {
-	service := &UserService{
-		logger: logger,
-		users:  make(map[string]*User),
+func NewUserService(store storage.Storage) *UserService {
+	return &UserService{
+		storage: store,
 	}
-	
-	// Initialize with some sample data
-	service.initializeSampleData()
-	
-	return service
 }
 
-func (s *UserService) initializeSampleData() {
-	sampleUsers := []*User{
-		{
-			ID:        "1",
-			Name:      "John Doe",
-			Email:     "john.doe@example.com",
-			Age:       30,
-			CreatedAt: time.Now().Add(-24 * time.Hour),
-			UpdatedAt: time.Now().Add(-24 * time.Hour),
-		},
-		{
-			ID:        "2",
-			Name:      "Jane Smith",
-			Email:     "jane.smith@example.com",
-			Age:       25,
-			CreatedAt: time.Now().Add(-12 * time.Hour),
-			UpdatedAt: time.Now().Add(-12 * time.Hour),
-		},
-	}
-
-	for _, user := range sampleUsers {
-		s.users[user.ID] = user
-	}
-	
-	s.logger.Info("Initialized sample user data")
+// Ready reports whether the underlying storage backend is reachable, so the
+// HTTP /ready probe reflects real storage health rather than process liveness.
+func (s *UserService) Ready(ctx context.Context) error {
+	return s.storage.Ping(ctx)
 }
 
 func (s *UserService) GetUser(ctx context.Context, req *proto.GetUserRequest) (*proto.GetUserResponse, error) {
-	s.logger.WithField("user_id", req.Id).Info("Getting user")
-	
-	user, exists := s.users[req.Id]
-	if !exists {
-		return &proto.GetUserResponse{
-			Success: false,
-			Message: "User not found",
-		}, nil
+	logger.FromContext(ctx).Info("Getting user", zap.String("user_id", req.Id))
+
+	if err := validation.ValidateID(req.Id); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	user, err := s.storage.Get(ctx, req.Id)
+	if errors.Is(err, storage.ErrNotFound) {
+		return nil, status.Errorf(codes.NotFound, "user %q not found", req.Id)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "get user: %v", err)
 	}
 
 	return &proto.GetUserResponse{
-		User:    s.convertToProtoUser(user),
+		User:    convertToProtoUser(user),
 		Success: true,
 		Message: "User retrieved successfully",
 	}, nil
 }
 
 func (s *UserService) CreateUser(ctx context.Context, req *proto.CreateUserRequest) (*proto.CreateUserResponse, error) {
-	s.logger.WithFields(logrus.Fields{
-		"name":  req.Name,
-		"email": req.Email,
-		"age":   req.Age,
-	}).Info("Creating user")
-
-	// Generate a simple ID (in production, use UUID)
-	userID := fmt.Sprintf("%d", len(s.users)+1)
-	
-	user := &User{
-		ID:        userID,
+	logger.FromContext(ctx).Info("Creating user",
+		zap.String("name", req.Name),
+		zap.String("email", req.Email),
+		zap.Int32("age", req.Age),
+	)
+
+	if err := validation.ValidateCreateUser(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	now := time.Now()
+	user := &storage.User{
+		ID:        uuid.NewString(),
 		Name:      req.Name,
 		Email:     req.Email,
 		Age:       req.Age,
-		CreatedAt: time.Now(),
-		UpdatedAt: time.Now(),
+		CreatedAt: now,
+		UpdatedAt: now,
 	}
 
-	s.users[userID] = user
+	if err := s.storage.Create(ctx, user); err != nil {
+		if errors.Is(err, storage.ErrAlreadyExists) {
+			return nil, status.Errorf(codes.AlreadyExists, "user with email %q already exists", req.Email)
+		}
+		return nil, status.Errorf(codes.Internal, "create user: %v", err)
+	}
 
 	return &proto.CreateUserResponse{
-		User:    s.convertToProtoUser(user),
+		User:    convertToProtoUser(user),
 		Success: true,
 		Message: "User created successfully",
 	}, nil
 }
 
 func (s *UserService) UpdateUser(ctx context.Context, req *proto.UpdateUserRequest) (*proto.UpdateUserResponse, error) {
-	s.logger.WithField("user_id", req.Id).Info("Updating user")
-	
-	user, exists := s.users[req.Id]
-	if !exists {
-		return &proto.UpdateUserResponse{
-			Success: false,
-			Message: "User not found",
-		}, nil
+	logger.FromContext(ctx).Info("Updating user", zap.String("user_id", req.Id))
+
+	if err := validation.ValidateUpdateUser(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	user, err := s.storage.Get(ctx, req.Id)
+	if errors.Is(err, storage.ErrNotFound) {
+		return nil, status.Errorf(codes.NotFound, "user %q not found", req.Id)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "get user: %v", err)
 	}
 
 	// Update fields if provided
@@ -132,25 +116,34 @@ func (s *UserService) UpdateUser(ctx context.Context, req *proto.UpdateUserReque
 	}
 	user.UpdatedAt = time.Now()
 
+	if err := s.storage.Update(ctx, user); err != nil {
+		if errors.Is(err, storage.ErrAlreadyExists) {
+			return nil, status.Errorf(codes.AlreadyExists, "user with email %q already exists", user.Email)
+		}
+		return nil, status.Errorf(codes.Internal, "update user: %v", err)
+	}
+
 	return &proto.UpdateUserResponse{
-		User:    s.convertToProtoUser(user),
+		User:    convertToProtoUser(user),
 		Success: true,
 		Message: "User updated successfully",
 	}, nil
 }
 
 func (s *UserService) DeleteUser(ctx context.Context, req *proto.DeleteUserRequest) (*proto.DeleteUserResponse, error) {
-	s.logger.WithField("user_id", req.Id).Info("Deleting user")
-	
-	_, exists := s.users[req.Id]
-	if !exists {
-		return &proto.DeleteUserResponse{
-			Success: false,
-			Message: "User not found",
-		}, nil
+	logger.FromContext(ctx).Info("Deleting user", zap.String("user_id", req.Id))
+
+	if err := validation.ValidateID(req.Id); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	delete(s.users, req.Id)
+	err := s.storage.Delete(ctx, req.Id)
+	if errors.Is(err, storage.ErrNotFound) {
+		return nil, status.Errorf(codes.NotFound, "user %q not found", req.Id)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "delete user: %v", err)
+	}
 
 	return &proto.DeleteUserResponse{
 		Success: true,
@@ -159,49 +152,49 @@ func (s *UserService) DeleteUser(ctx context.Context, req *proto.DeleteUserReque
 }
 
 func (s *UserService) ListUsers(ctx context.Context, req *proto.ListUsersRequest) (*proto.ListUsersResponse, error) {
-	s.logger.WithFields(logrus.Fields{
-		"page":  req.Page,
-		"limit": req.Limit,
-	}).Info("Listing users")
-
-	// Simple pagination
-	allUsers := make([]*User, 0, len(s.users))
-	for _, user := range s.users {
-		allUsers = append(allUsers, user)
-	}
-
-	// Apply pagination
-	start := int((req.Page - 1) * req.Limit)
-	end := start + int(req.Limit)
-	
-	if start >= len(allUsers) {
+	logger.FromContext(ctx).Info("Listing users",
+		zap.Int32("page", req.Page),
+		zap.Int32("limit", req.Limit),
+	)
+
+	if req.Page < 1 || req.Limit < 1 {
+		return nil, status.Error(codes.InvalidArgument, "page and limit must be positive")
+	}
+
+	offset := int((req.Page - 1) * req.Limit)
+	users, err := s.storage.List(ctx, offset, int(req.Limit))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list users: %v", err)
+	}
+
+	total, err := s.storage.Count(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "count users: %v", err)
+	}
+
+	if len(users) == 0 {
 		return &proto.ListUsersResponse{
 			Users:   []*proto.User{},
-			Total:   int32(len(allUsers)),
+			Total:   int32(total),
 			Success: true,
 			Message: "No users found for the given page",
 		}, nil
 	}
-	
-	if end > len(allUsers) {
-		end = len(allUsers)
-	}
 
-	paginatedUsers := allUsers[start:end]
-	protoUsers := make([]*proto.User, len(paginatedUsers))
-	for i, user := range paginatedUsers {
-		protoUsers[i] = s.convertToProtoUser(user)
+	protoUsers := make([]*proto.User, len(users))
+	for i, user := range users {
+		protoUsers[i] = convertToProtoUser(user)
 	}
 
 	return &proto.ListUsersResponse{
 		Users:   protoUsers,
-		Total:   int32(len(allUsers)),
+		Total:   int32(total),
 		Success: true,
 		Message: "Users retrieved successfully",
 	}, nil
 }
 
-func (s *UserService) convertToProtoUser(user *User) *proto.User {
+func convertToProtoUser(user *storage.User) *proto.User {
 	return &proto.User{
 		Id:        user.ID,
 		Name:      user.Name,
@@ -0,0 +1,82 @@
+package storage
+
+import (
+	"context"
+	"time"
+
+	"user-service/src/metrics"
+)
+
+// Instrumented wraps a Storage and records
+// user_service_storage_operations_total / user_service_storage_operation_duration_seconds
+// for every call, regardless of which backend is active.
+type Instrumented struct {
+	inner Storage
+}
+
+// NewInstrumented wraps inner with Prometheus instrumentation.
+func NewInstrumented(inner Storage) *Instrumented {
+	return &Instrumented{inner: inner}
+}
+
+func observe(operation string, start time.Time, err error) {
+	metrics.StorageOperationDuration.WithLabelValues(operation).Observe(time.Since(start).Seconds())
+	result := "success"
+	if err != nil {
+		result = "error"
+	}
+	metrics.StorageOperationsTotal.WithLabelValues(operation, result).Inc()
+}
+
+func (s *Instrumented) Get(ctx context.Context, id string) (*User, error) {
+	start := time.Now()
+	user, err := s.inner.Get(ctx, id)
+	observe("get", start, err)
+	return user, err
+}
+
+func (s *Instrumented) Create(ctx context.Context, user *User) error {
+	start := time.Now()
+	err := s.inner.Create(ctx, user)
+	observe("create", start, err)
+	return err
+}
+
+func (s *Instrumented) Update(ctx context.Context, user *User) error {
+	start := time.Now()
+	err := s.inner.Update(ctx, user)
+	observe("update", start, err)
+	return err
+}
+
+func (s *Instrumented) Delete(ctx context.Context, id string) error {
+	start := time.Now()
+	err := s.inner.Delete(ctx, id)
+	observe("delete", start, err)
+	return err
+}
+
+func (s *Instrumented) List(ctx context.Context, offset, limit int) ([]*User, error) {
+	start := time.Now()
+	users, err := s.inner.List(ctx, offset, limit)
+	observe("list", start, err)
+	return users, err
+}
+
+func (s *Instrumented) Count(ctx context.Context) (int, error) {
+	start := time.Now()
+	count, err := s.inner.Count(ctx)
+	observe("count", start, err)
+	return count, err
+}
+
+func (s *Instrumented) Ping(ctx context.Context) error {
+	start := time.Now()
+	err := s.inner.Ping(ctx)
+	observe("ping", start, err)
+	return err
+}
+
+func (s *Instrumented) Close() {
+	s.inner.Close()
+}
@@ -0,0 +1,112 @@
+package storage
+
+import (
+	"context"
+	"sort"
+	"sync"
+)
+
+// MemoryStorage is a process-local, non-persistent Storage implementation.
+// It preserves the behavior of the original map-backed UserService and is
+// primarily intended for tests and local development.
+type MemoryStorage struct {
+	mu    sync.RWMutex
+	users map[string]*User
+}
+
+// NewMemoryStorage returns an empty MemoryStorage.
+func NewMemoryStorage() *MemoryStorage {
+	return &MemoryStorage{
+		users: make(map[string]*User),
+	}
+}
+
+func (s *MemoryStorage) Get(ctx context.Context, id string) (*User, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	user, ok := s.users[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	copied := *user
+	return &copied, nil
+}
+
+func (s *MemoryStorage) Create(ctx context.Context, user *User) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, existing := range s.users {
+		if existing.Email == user.Email {
+			return ErrAlreadyExists
+		}
+	}
+
+	copied := *user
+	s.users[user.ID] = &copied
+	return nil
+}
+
+func (s *MemoryStorage) Update(ctx context.Context, user *User) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.users[user.ID]; !ok {
+		return ErrNotFound
+	}
+
+	for id, existing := range s.users {
+		if id != user.ID && existing.Email == user.Email {
+			return ErrAlreadyExists
+		}
+	}
+
+	copied := *user
+	s.users[user.ID] = &copied
+	return nil
+}
+
+func (s *MemoryStorage) Delete(ctx context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.users[id]; !ok {
+		return ErrNotFound
+	}
+	delete(s.users, id)
+	return nil
+}
+
+func (s *MemoryStorage) List(ctx context.Context, offset, limit int) ([]*User, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	all := make([]*User, 0, len(s.users))
+	for _, user := range s.users {
+		copied := *user
+		all = append(all, &copied)
+	}
+	sort.Slice(all, func(i, j int) bool { return all[i].CreatedAt.Before(all[j].CreatedAt) })
+
+	if offset >= len(all) {
+		return []*User{}, nil
+	}
+	end := offset + limit
+	if end > len(all) {
+		end = len(all)
+	}
+	return all[offset:end], nil
+}
+
+func (s *MemoryStorage) Count(ctx context.Context) (int, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.users), nil
+}
+
+func (s *MemoryStorage) Ping(ctx context.Context) error {
+	return nil
+}
+
+func (s *MemoryStorage) Close() {}
@@ -0,0 +1,110 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestMemoryStorageCreateRejectsDuplicateEmail(t *testing.T) {
+	s := NewMemoryStorage()
+	ctx := context.Background()
+
+	if err := s.Create(ctx, &User{ID: "1", Email: "a@example.com"}); err != nil {
+		t.Fatalf("Create(1): %v", err)
+	}
+	err := s.Create(ctx, &User{ID: "2", Email: "a@example.com"})
+	if !errors.Is(err, ErrAlreadyExists) {
+		t.Fatalf("Create(2) with duplicate email: got %v, want ErrAlreadyExists", err)
+	}
+}
+
+func TestMemoryStorageUpdateRejectsDuplicateEmail(t *testing.T) {
+	s := NewMemoryStorage()
+	ctx := context.Background()
+
+	if err := s.Create(ctx, &User{ID: "1", Email: "a@example.com"}); err != nil {
+		t.Fatalf("Create(1): %v", err)
+	}
+	if err := s.Create(ctx, &User{ID: "2", Email: "b@example.com"}); err != nil {
+		t.Fatalf("Create(2): %v", err)
+	}
+
+	err := s.Update(ctx, &User{ID: "2", Email: "a@example.com"})
+	if !errors.Is(err, ErrAlreadyExists) {
+		t.Fatalf("Update(2) to 1's email: got %v, want ErrAlreadyExists", err)
+	}
+}
+
+func TestMemoryStorageUpdateAllowsKeepingOwnEmail(t *testing.T) {
+	s := NewMemoryStorage()
+	ctx := context.Background()
+
+	if err := s.Create(ctx, &User{ID: "1", Name: "old", Email: "a@example.com"}); err != nil {
+		t.Fatalf("Create(1): %v", err)
+	}
+
+	if err := s.Update(ctx, &User{ID: "1", Name: "new", Email: "a@example.com"}); err != nil {
+		t.Fatalf("Update(1) with unchanged email: %v", err)
+	}
+
+	got, err := s.Get(ctx, "1")
+	if err != nil {
+		t.Fatalf("Get(1): %v", err)
+	}
+	if got.Name != "new" {
+		t.Fatalf("Get(1).Name = %q, want %q", got.Name, "new")
+	}
+}
+
+func TestMemoryStorageUpdateNotFound(t *testing.T) {
+	s := NewMemoryStorage()
+	err := s.Update(context.Background(), &User{ID: "missing", Email: "a@example.com"})
+	if !errors.Is(err, ErrNotFound) {
+		t.Fatalf("Update(missing): got %v, want ErrNotFound", err)
+	}
+}
+
+func TestMemoryStorageCountAndList(t *testing.T) {
+	s := NewMemoryStorage()
+	ctx := context.Background()
+	base := time.Now()
+
+	for i, id := range []string{"1", "2", "3"} {
+		user := &User{ID: id, Email: id + "@example.com", CreatedAt: base.Add(time.Duration(i) * time.Second)}
+		if err := s.Create(ctx, user); err != nil {
+			t.Fatalf("Create(%s): %v", id, err)
+		}
+	}
+
+	count, err := s.Count(ctx)
+	if err != nil {
+		t.Fatalf("Count: %v", err)
+	}
+	if count != 3 {
+		t.Fatalf("Count = %d, want 3", count)
+	}
+
+	page, err := s.List(ctx, 1, 1)
+	if err != nil {
+		t.Fatalf("List(1, 1): %v", err)
+	}
+	if len(page) != 1 || page[0].ID != "2" {
+		t.Fatalf("List(1, 1) = %+v, want [user 2]", page)
+	}
+
+	page, err = s.List(ctx, 10, 10)
+	if err != nil {
+		t.Fatalf("List(10, 10): %v", err)
+	}
+	if len(page) != 0 {
+		t.Fatalf("List(10, 10) = %+v, want empty for offset past the end", page)
+	}
+}
+
+func TestMemoryStoragePing(t *testing.T) {
+	if err := NewMemoryStorage().Ping(context.Background()); err != nil {
+		t.Fatalf("Ping: %v", err)
+	}
+}
@@ -0,0 +1,158 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// usersSchema is applied on startup so a fresh database is ready to serve
+// requests without a separate migration step.
+const usersSchema = `
+CREATE TABLE IF NOT EXISTS users (
+	id         UUID PRIMARY KEY,
+	name       TEXT NOT NULL,
+	email      TEXT NOT NULL UNIQUE,
+	age        INTEGER NOT NULL,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+	updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+`
+
+// PostgresConfig configures the pgx connection pool.
+type PostgresConfig struct {
+	URL      string
+	PoolSize int
+}
+
+// PostgresStorage stores users in a PostgreSQL "users" table via pgx.
+type PostgresStorage struct {
+	pool *pgxpool.Pool
+}
+
+// NewPostgresStorage connects to PostgreSQL and ensures the users table exists.
+func NewPostgresStorage(ctx context.Context, cfg PostgresConfig) (*PostgresStorage, error) {
+	poolCfg, err := pgxpool.ParseConfig(cfg.URL)
+	if err != nil {
+		return nil, fmt.Errorf("parse postgres url: %w", err)
+	}
+	if cfg.PoolSize > 0 {
+		poolCfg.MaxConns = int32(cfg.PoolSize)
+	}
+
+	pool, err := pgxpool.NewWithConfig(ctx, poolCfg)
+	if err != nil {
+		return nil, fmt.Errorf("create postgres pool: %w", err)
+	}
+
+	if _, err := pool.Exec(ctx, usersSchema); err != nil {
+		pool.Close()
+		return nil, fmt.Errorf("migrate users table: %w", err)
+	}
+
+	return &PostgresStorage{pool: pool}, nil
+}
+
+func (s *PostgresStorage) Get(ctx context.Context, id string) (*User, error) {
+	var u User
+	err := s.pool.QueryRow(ctx,
+		`SELECT id, name, email, age, created_at, updated_at FROM users WHERE id = $1`, id,
+	).Scan(&u.ID, &u.Name, &u.Email, &u.Age, &u.CreatedAt, &u.UpdatedAt)
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get user: %w", err)
+	}
+	return &u, nil
+}
+
+func (s *PostgresStorage) Create(ctx context.Context, user *User) error {
+	_, err := s.pool.Exec(ctx,
+		`INSERT INTO users (id, name, email, age, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6)`,
+		user.ID, user.Name, user.Email, user.Age, user.CreatedAt, user.UpdatedAt,
+	)
+	if isUniqueViolation(err) {
+		return ErrAlreadyExists
+	}
+	if err != nil {
+		return fmt.Errorf("create user: %w", err)
+	}
+	return nil
+}
+
+func (s *PostgresStorage) Update(ctx context.Context, user *User) error {
+	tag, err := s.pool.Exec(ctx,
+		`UPDATE users SET name = $1, email = $2, age = $3, updated_at = $4 WHERE id = $5`,
+		user.Name, user.Email, user.Age, user.UpdatedAt, user.ID,
+	)
+	if isUniqueViolation(err) {
+		return ErrAlreadyExists
+	}
+	if err != nil {
+		return fmt.Errorf("update user: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (s *PostgresStorage) Delete(ctx context.Context, id string) error {
+	tag, err := s.pool.Exec(ctx, `DELETE FROM users WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("delete user: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (s *PostgresStorage) List(ctx context.Context, offset, limit int) ([]*User, error) {
+	rows, err := s.pool.Query(ctx,
+		`SELECT id, name, email, age, created_at, updated_at FROM users ORDER BY created_at OFFSET $1 LIMIT $2`,
+		offset, limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("list users: %w", err)
+	}
+	defer rows.Close()
+
+	users := make([]*User, 0, limit)
+	for rows.Next() {
+		var u User
+		if err := rows.Scan(&u.ID, &u.Name, &u.Email, &u.Age, &u.CreatedAt, &u.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("scan user: %w", err)
+		}
+		users = append(users, &u)
+	}
+	return users, rows.Err()
+}
+
+func (s *PostgresStorage) Count(ctx context.Context) (int, error) {
+	var count int
+	if err := s.pool.QueryRow(ctx, `SELECT count(*) FROM users`).Scan(&count); err != nil {
+		return 0, fmt.Errorf("count users: %w", err)
+	}
+	return count, nil
+}
+
+func (s *PostgresStorage) Ping(ctx context.Context) error {
+	return s.pool.Ping(ctx)
+}
+
+func (s *PostgresStorage) Close() {
+	s.pool.Close()
+}
+
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	// "23505" is PostgreSQL's unique_violation error code.
+	return errors.As(err, &pgErr) && pgErr.Code == "23505"
+}
@@ -0,0 +1,199 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// usersIndexKey is a sorted set of user IDs scored by creation time, used to
+// give List a stable, paginatable ordering without a SCAN over the keyspace.
+const usersIndexKey = "users:index"
+
+// RedisConfig configures the Valkey/Redis client.
+type RedisConfig struct {
+	URL      string
+	PoolSize int
+}
+
+// RedisStorage stores each user as a hash (user:<id>) plus an entry in
+// usersIndexKey for pagination. It is compatible with both Redis and Valkey.
+type RedisStorage struct {
+	client *redis.Client
+}
+
+// NewRedisStorage connects to the configured Valkey/Redis instance.
+func NewRedisStorage(cfg RedisConfig) (*RedisStorage, error) {
+	opts, err := redis.ParseURL(cfg.URL)
+	if err != nil {
+		return nil, fmt.Errorf("parse redis url: %w", err)
+	}
+	if cfg.PoolSize > 0 {
+		opts.PoolSize = cfg.PoolSize
+	}
+	return &RedisStorage{client: redis.NewClient(opts)}, nil
+}
+
+func userKey(id string) string {
+	return "user:" + id
+}
+
+func (s *RedisStorage) Get(ctx context.Context, id string) (*User, error) {
+	fields, err := s.client.HGetAll(ctx, userKey(id)).Result()
+	if err != nil {
+		return nil, fmt.Errorf("get user: %w", err)
+	}
+	if len(fields) == 0 {
+		return nil, ErrNotFound
+	}
+	return fieldsToUser(id, fields)
+}
+
+// Create atomically reserves the email index key with SETNX before writing
+// the user hash, so two concurrent creates for the same new email can't
+// both pass a check-then-set race: only the caller that wins the SETNX
+// proceeds to write.
+func (s *RedisStorage) Create(ctx context.Context, user *User) error {
+	ok, err := s.client.SetNX(ctx, emailIndexKey(user.Email), user.ID, 0).Result()
+	if err != nil {
+		return fmt.Errorf("reserve email: %w", err)
+	}
+	if !ok {
+		return ErrAlreadyExists
+	}
+
+	pipe := s.client.TxPipeline()
+	pipe.HSet(ctx, userKey(user.ID), userToFields(user))
+	pipe.ZAdd(ctx, usersIndexKey, redis.Z{Score: float64(user.CreatedAt.UnixNano()), Member: user.ID})
+	if _, err := pipe.Exec(ctx); err != nil {
+		s.client.Del(ctx, emailIndexKey(user.Email))
+		return fmt.Errorf("create user: %w", err)
+	}
+	return nil
+}
+
+// Update reserves the new email via the same SETNX as Create before
+// releasing the old one, so it enforces the same uniqueness invariant and
+// closes the same race when the email is changing.
+func (s *RedisStorage) Update(ctx context.Context, user *User) error {
+	existing, err := s.Get(ctx, user.ID)
+	if err != nil {
+		return err
+	}
+
+	if existing.Email != user.Email {
+		ok, err := s.client.SetNX(ctx, emailIndexKey(user.Email), user.ID, 0).Result()
+		if err != nil {
+			return fmt.Errorf("reserve email: %w", err)
+		}
+		if !ok {
+			return ErrAlreadyExists
+		}
+		if err := s.client.Del(ctx, emailIndexKey(existing.Email)).Err(); err != nil {
+			s.client.Del(ctx, emailIndexKey(user.Email))
+			return fmt.Errorf("release old email: %w", err)
+		}
+	}
+
+	if err := s.client.HSet(ctx, userKey(user.ID), userToFields(user)).Err(); err != nil {
+		if existing.Email != user.Email {
+			s.client.Del(ctx, emailIndexKey(user.Email))
+		}
+		return fmt.Errorf("update user: %w", err)
+	}
+	return nil
+}
+
+func (s *RedisStorage) Delete(ctx context.Context, id string) error {
+	user, err := s.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	pipe := s.client.TxPipeline()
+	pipe.Del(ctx, userKey(id))
+	pipe.Del(ctx, emailIndexKey(user.Email))
+	pipe.ZRem(ctx, usersIndexKey, id)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("delete user: %w", err)
+	}
+	return nil
+}
+
+func (s *RedisStorage) List(ctx context.Context, offset, limit int) ([]*User, error) {
+	ids, err := s.client.ZRange(ctx, usersIndexKey, int64(offset), int64(offset+limit-1)).Result()
+	if err != nil {
+		return nil, fmt.Errorf("list user ids: %w", err)
+	}
+
+	users := make([]*User, 0, len(ids))
+	for _, id := range ids {
+		user, err := s.Get(ctx, id)
+		if errors.Is(err, ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		users = append(users, user)
+	}
+	return users, nil
+}
+
+func (s *RedisStorage) Count(ctx context.Context) (int, error) {
+	count, err := s.client.ZCard(ctx, usersIndexKey).Result()
+	if err != nil {
+		return 0, fmt.Errorf("count users: %w", err)
+	}
+	return int(count), nil
+}
+
+func (s *RedisStorage) Ping(ctx context.Context) error {
+	return s.client.Ping(ctx).Err()
+}
+
+func (s *RedisStorage) Close() {
+	s.client.Close()
+}
+
+func emailIndexKey(email string) string {
+	return "user:email:" + email
+}
+
+func userToFields(user *User) map[string]interface{} {
+	return map[string]interface{}{
+		"name":       user.Name,
+		"email":      user.Email,
+		"age":        user.Age,
+		"created_at": user.CreatedAt.Format(time.RFC3339Nano),
+		"updated_at": user.UpdatedAt.Format(time.RFC3339Nano),
+	}
+}
+
+func fieldsToUser(id string, fields map[string]string) (*User, error) {
+	age, err := strconv.ParseInt(fields["age"], 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("parse age: %w", err)
+	}
+	createdAt, err := time.Parse(time.RFC3339Nano, fields["created_at"])
+	if err != nil {
+		return nil, fmt.Errorf("parse created_at: %w", err)
+	}
+	updatedAt, err := time.Parse(time.RFC3339Nano, fields["updated_at"])
+	if err != nil {
+		return nil, fmt.Errorf("parse updated_at: %w", err)
+	}
+
+	return &User{
+		ID:        id,
+		Name:      fields["name"],
+		Email:     fields["email"],
+		Age:       int32(age),
+		CreatedAt: createdAt,
+		UpdatedAt: updatedAt,
+	}, nil
+}
@@ -0,0 +1,44 @@
+// Package storage defines the persistence contract for user records and
+// the concrete backends that implement it (in-memory, PostgreSQL, Redis/Valkey).
+package storage
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrNotFound is returned when a lookup does not match any stored user.
+var ErrNotFound = errors.New("storage: user not found")
+
+// ErrAlreadyExists is returned when a Create would violate the unique email constraint.
+var ErrAlreadyExists = errors.New("storage: user already exists")
+
+// User is the storage-layer representation of a user record.
+type User struct {
+	ID        string
+	Name      string
+	Email     string
+	Age       int32
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Storage is implemented by every user persistence backend. Implementations
+// must return ErrNotFound / ErrAlreadyExists so callers can map them to the
+// appropriate gRPC status codes without knowing which backend is in use.
+type Storage interface {
+	Get(ctx context.Context, id string) (*User, error)
+	Create(ctx context.Context, user *User) error
+	Update(ctx context.Context, user *User) error
+	Delete(ctx context.Context, id string) error
+	List(ctx context.Context, offset, limit int) ([]*User, error)
+	Count(ctx context.Context) (int, error)
+
+	// Ping verifies connectivity to the backing store and is used by the
+	// /ready probe. In-memory storage always succeeds.
+	Ping(ctx context.Context) error
+
+	// Close releases any resources (connections, pools) held by the backend.
+	Close()
+}
@@ -0,0 +1,78 @@
+// Package validation holds request-shape checks for the UserService RPCs,
+// kept separate from services so the same rules can be reused or tested
+// without a storage backend.
+package validation
+
+import (
+	"fmt"
+	"regexp"
+
+	"user-service/proto"
+)
+
+var emailRegex = regexp.MustCompile(`^[^\s@]+@[^\s@]+\.[^\s@]+$`)
+
+const (
+	minAge = 0
+	maxAge = 130
+)
+
+// ValidateID checks that an ID path parameter was supplied.
+func ValidateID(id string) error {
+	if id == "" {
+		return fmt.Errorf("id is required")
+	}
+	return nil
+}
+
+// ValidateCreateUser checks that req has a name, a well-formed email, and an
+// age within [minAge, maxAge].
+func ValidateCreateUser(req *proto.CreateUserRequest) error {
+	if req.Name == "" {
+		return fmt.Errorf("name is required")
+	}
+	if err := validateEmail(req.Email); err != nil {
+		return err
+	}
+	if err := validateAge(req.Age); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ValidateUpdateUser checks req.Id is set and that any provided email/age
+// are well-formed. Empty/zero fields mean "leave unchanged" and are skipped,
+// matching UserService.UpdateUser's partial-update semantics.
+func ValidateUpdateUser(req *proto.UpdateUserRequest) error {
+	if err := ValidateID(req.Id); err != nil {
+		return err
+	}
+	if req.Email != "" {
+		if err := validateEmail(req.Email); err != nil {
+			return err
+		}
+	}
+	if req.Age != 0 {
+		if err := validateAge(req.Age); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateEmail(email string) error {
+	if email == "" {
+		return fmt.Errorf("email is required")
+	}
+	if !emailRegex.MatchString(email) {
+		return fmt.Errorf("email %q is not a valid email address", email)
+	}
+	return nil
+}
+
+func validateAge(age int32) error {
+	if age < minAge || age > maxAge {
+		return fmt.Errorf("age must be between %d and %d", minAge, maxAge)
+	}
+	return nil
+}
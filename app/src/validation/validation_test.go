@@ -0,0 +1,65 @@
+package validation
+
+import (
+	"testing"
+
+	"user-service/proto"
+)
+
+func TestValidateID(t *testing.T) {
+	if err := ValidateID(""); err == nil {
+		t.Fatal("ValidateID(\"\") = nil, want error")
+	}
+	if err := ValidateID("1"); err != nil {
+		t.Fatalf("ValidateID(\"1\"): %v", err)
+	}
+}
+
+func TestValidateCreateUser(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     *proto.CreateUserRequest
+		wantErr bool
+	}{
+		{"valid", &proto.CreateUserRequest{Name: "Ada", Email: "ada@example.com", Age: 30}, false},
+		{"missing name", &proto.CreateUserRequest{Email: "ada@example.com", Age: 30}, true},
+		{"missing email", &proto.CreateUserRequest{Name: "Ada", Age: 30}, true},
+		{"malformed email", &proto.CreateUserRequest{Name: "Ada", Email: "not-an-email", Age: 30}, true},
+		{"negative age", &proto.CreateUserRequest{Name: "Ada", Email: "ada@example.com", Age: -1}, true},
+		{"age over max", &proto.CreateUserRequest{Name: "Ada", Email: "ada@example.com", Age: 131}, true},
+		{"age at boundary", &proto.CreateUserRequest{Name: "Ada", Email: "ada@example.com", Age: 130}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateCreateUser(tt.req)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ValidateCreateUser(%+v) = %v, wantErr %v", tt.req, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateUpdateUser(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     *proto.UpdateUserRequest
+		wantErr bool
+	}{
+		{"id only", &proto.UpdateUserRequest{Id: "1"}, false},
+		{"missing id", &proto.UpdateUserRequest{Email: "ada@example.com"}, true},
+		{"valid email change", &proto.UpdateUserRequest{Id: "1", Email: "ada@example.com"}, false},
+		{"malformed email change", &proto.UpdateUserRequest{Id: "1", Email: "not-an-email"}, true},
+		{"valid age change", &proto.UpdateUserRequest{Id: "1", Age: 40}, false},
+		{"invalid age change", &proto.UpdateUserRequest{Id: "1", Age: 200}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateUpdateUser(tt.req)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ValidateUpdateUser(%+v) = %v, wantErr %v", tt.req, err, tt.wantErr)
+			}
+		})
+	}
+}